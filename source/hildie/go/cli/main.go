@@ -1,17 +1,61 @@
 package main
 
 import (
-	"flag"
+	"encoding/json"
 	"fmt"
-	"github.com/clintonsteiner/hildie-go/lib"
+	"os"
+	"strings"
+
+	"github.com/clintonsteiner/hildie-go/cli/parser"
 )
 
+// parseArgs pulls --json and --lang out of args, wherever they appear, and
+// returns the remaining tokens as operations. It avoids the standard flag
+// package because sigil tokens like "-Alice" look like unknown flags to it
+// and would abort parsing before reaching the chained operations below.
+func parseArgs(args []string) (jsonOutput bool, lang string, ops []string) {
+	lang = "en"
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--json":
+			jsonOutput = true
+		case args[i] == "--lang":
+			if i+1 < len(args) {
+				i++
+				lang = args[i]
+			}
+		case strings.HasPrefix(args[i], "--lang="):
+			lang = strings.TrimPrefix(args[i], "--lang=")
+		default:
+			ops = append(ops, args[i])
+		}
+	}
+	return jsonOutput, lang, ops
+}
+
 func main() {
-	if flag.NArg() == 0 {
-		fmt.Println("Usage: hildie-cli <name>")
+	jsonOutput, lang, ops := parseArgs(os.Args[1:])
+
+	if len(ops) == 0 {
+		fmt.Println("Usage: hildie-cli [--json] [--lang en|es|fr] <+name|-name|=a,b> ...")
 		return
 	}
 
-	name := flag.Arg(0)
-	fmt.Println(lib.Greet(name))
+	for _, r := range parser.Run(ops, lang) {
+		if jsonOutput {
+			enc, err := json.Marshal(r)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				continue
+			}
+			fmt.Println(string(enc))
+			continue
+		}
+
+		if r.Error != "" {
+			fmt.Printf("%s(%s): error: %s\n", r.Op, r.Input, r.Error)
+			continue
+		}
+		fmt.Println(r.Result)
+	}
 }
@@ -0,0 +1,77 @@
+// Package parser implements hildie-cli's chained operation syntax: each
+// argv token carries a leading sigil ('+', '-', '=') selecting which lib
+// function to call, so a single invocation can run several operations in
+// order without a subcommand per operation.
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/clintonsteiner/hildie-go/lib"
+)
+
+// Result is the outcome of a single chained operation. It is tagged for
+// JSON so hildie-cli's --json flag can emit it directly.
+type Result struct {
+	Op     string `json:"op"`
+	Input  string `json:"input"`
+	Result string `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Run tokenizes each arg by its leading sigil and dispatches it to the
+// matching lib function. lang selects the greeter used for '+' tokens. A
+// bad token produces a Result with Error set rather than aborting the
+// remaining tokens.
+func Run(args []string, lang string) []Result {
+	results := make([]Result, 0, len(args))
+	for _, arg := range args {
+		results = append(results, run(arg, lang))
+	}
+	return results
+}
+
+func run(arg, lang string) Result {
+	if arg == "" {
+		return Result{Op: "?", Input: arg, Error: "empty token"}
+	}
+
+	sigil, input := arg[:1], arg[1:]
+	switch sigil {
+	case "+":
+		greeting, err := lib.GreetIn(lang, input)
+		if err != nil {
+			return Result{Op: "greet", Input: input, Error: err.Error()}
+		}
+		return Result{Op: "greet", Input: input, Result: greeting}
+	case "-":
+		return Result{Op: "farewell", Input: input, Result: lib.Farewell(input)}
+	case "=":
+		a, b, err := parseOperands(input)
+		if err != nil {
+			return Result{Op: "add", Input: input, Error: err.Error()}
+		}
+		return Result{Op: "add", Input: input, Result: strconv.Itoa(lib.Add(a, b))}
+	default:
+		return Result{Op: "?", Input: arg, Error: fmt.Sprintf("unrecognized sigil %q", sigil)}
+	}
+}
+
+func parseOperands(input string) (int, int, error) {
+	parts := strings.Split(input, ",")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("expected two comma-separated ints, got %q", input)
+	}
+
+	a, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid int %q", parts[0])
+	}
+	b, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid int %q", parts[1])
+	}
+	return a, b, nil
+}
@@ -0,0 +1,113 @@
+package parser
+
+import (
+	"testing"
+)
+
+func TestRunGreet(t *testing.T) {
+	results := Run([]string{"+World"}, "en")
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	r := results[0]
+	if r.Op != "greet" || r.Error != "" {
+		t.Fatalf("unexpected result: %+v", r)
+	}
+	expected := "Hello from Hildie Go Library, World!"
+	if r.Result != expected {
+		t.Errorf("Result = %q, want %q", r.Result, expected)
+	}
+}
+
+func TestRunGreetUnknownLang(t *testing.T) {
+	r := Run([]string{"+World"}, "de")[0]
+	if r.Op != "greet" || r.Error == "" {
+		t.Errorf("expected an error for unregistered lang, got %+v", r)
+	}
+}
+
+func TestRunFarewell(t *testing.T) {
+	r := Run([]string{"-Alice"}, "en")[0]
+	if r.Op != "farewell" || r.Error != "" {
+		t.Fatalf("unexpected result: %+v", r)
+	}
+	expected := "Goodbye from Hildie Go Library, Alice!"
+	if r.Result != expected {
+		t.Errorf("Result = %q, want %q", r.Result, expected)
+	}
+}
+
+func TestRunAdd(t *testing.T) {
+	r := Run([]string{"=2,3"}, "en")[0]
+	if r.Op != "add" || r.Error != "" {
+		t.Fatalf("unexpected result: %+v", r)
+	}
+	if r.Result != "5" {
+		t.Errorf("Result = %q, want %q", r.Result, "5")
+	}
+}
+
+func TestRunEmptyToken(t *testing.T) {
+	r := Run([]string{""}, "en")[0]
+	if r.Op != "?" || r.Error == "" {
+		t.Errorf("expected an error for an empty token, got %+v", r)
+	}
+}
+
+func TestRunUnrecognizedSigil(t *testing.T) {
+	r := Run([]string{"?Bob"}, "en")[0]
+	if r.Op != "?" || r.Error == "" {
+		t.Errorf("expected an error for an unrecognized sigil, got %+v", r)
+	}
+}
+
+func TestRunPreservesOrderAndAggregatesErrors(t *testing.T) {
+	results := Run([]string{"+World", "=bad", "-Alice"}, "en")
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if results[0].Error != "" {
+		t.Errorf("token 0: unexpected error: %s", results[0].Error)
+	}
+	if results[1].Error == "" {
+		t.Error("token 1: expected an error for a malformed operand")
+	}
+	if results[2].Error != "" {
+		t.Errorf("token 2: unexpected error: %s", results[2].Error)
+	}
+}
+
+func TestParseOperands(t *testing.T) {
+	cases := []struct {
+		name    string
+		input   string
+		a, b    int
+		wantErr bool
+	}{
+		{name: "simple", input: "2,3", a: 2, b: 3},
+		{name: "spaces", input: " 2 , 3 ", a: 2, b: 3},
+		{name: "negative", input: "-2,3", a: -2, b: 3},
+		{name: "missing operand", input: "2", wantErr: true},
+		{name: "too many operands", input: "1,2,3", wantErr: true},
+		{name: "non-numeric", input: "x,3", wantErr: true},
+		{name: "empty", input: "", wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			a, b, err := parseOperands(c.input)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got a=%d b=%d", a, b)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if a != c.a || b != c.b {
+				t.Errorf("parseOperands(%q) = (%d, %d), want (%d, %d)", c.input, a, b, c.a, c.b)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package lib
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
 
 // Greet returns a greeting message
 func Greet(name string) string {
@@ -11,3 +15,84 @@ func Greet(name string) string {
 func Add(a, b int) int {
 	return a + b
 }
+
+// Farewell returns a parting message
+func Farewell(name string) string {
+	return fmt.Sprintf("Goodbye from Hildie Go Library, %s!", name)
+}
+
+// ErrDivideByZero is returned by Div when b is zero.
+var ErrDivideByZero = errors.New("lib: divide by zero")
+
+// Sub returns the difference of two integers.
+func Sub(a, b int) int {
+	return a - b
+}
+
+// Mul returns the product of two integers.
+func Mul(a, b int) int {
+	return a * b
+}
+
+// Div returns the quotient of two integers, or ErrDivideByZero if b is zero.
+func Div(a, b int) (int, error) {
+	if b == 0 {
+		return 0, ErrDivideByZero
+	}
+	return a / b, nil
+}
+
+// Sum returns the sum of an arbitrary number of integers.
+func Sum(nums ...int) int {
+	total := 0
+	for _, n := range nums {
+		total += n
+	}
+	return total
+}
+
+// Number is the set of types AddG accepts.
+type Number interface {
+	~int | ~int64 | ~float64
+}
+
+// AddG returns the sum of two values of any Number type.
+func AddG[T Number](a, b T) T {
+	return a + b
+}
+
+var (
+	greetersMu sync.RWMutex
+	greeters   = map[string]func(string) string{}
+)
+
+// RegisterGreeter registers fn as the greeter for lang, overwriting any
+// greeter already registered for that language. It is safe to call
+// concurrently.
+func RegisterGreeter(lang string, fn func(string) string) {
+	greetersMu.Lock()
+	defer greetersMu.Unlock()
+	greeters[lang] = fn
+}
+
+// GreetIn greets name using the greeter registered for lang, or an error if
+// no greeter is registered for that language.
+func GreetIn(lang, name string) (string, error) {
+	greetersMu.RLock()
+	fn, ok := greeters[lang]
+	greetersMu.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("lib: no greeter registered for lang %q", lang)
+	}
+	return fn(name), nil
+}
+
+func init() {
+	RegisterGreeter("en", Greet)
+	RegisterGreeter("es", func(name string) string {
+		return fmt.Sprintf("¡Hola desde la Biblioteca Hildie Go, %s!", name)
+	})
+	RegisterGreeter("fr", func(name string) string {
+		return fmt.Sprintf("Bonjour de la Bibliothèque Hildie Go, %s!", name)
+	})
+}
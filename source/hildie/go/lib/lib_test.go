@@ -1,6 +1,8 @@
 package lib
 
 import (
+	"errors"
+	"sync"
 	"testing"
 )
 
@@ -18,3 +20,165 @@ func TestAdd(t *testing.T) {
 		t.Errorf("Expected 5, got %d", result)
 	}
 }
+
+func TestSub(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b int
+		want int
+	}{
+		{"positive", 5, 3, 2},
+		{"negative result", 3, 5, -2},
+		{"zero", 4, 4, 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Sub(c.a, c.b); got != c.want {
+				t.Errorf("Sub(%d, %d) = %d, want %d", c.a, c.b, got, c.want)
+			}
+		})
+	}
+}
+
+func TestMul(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b int
+		want int
+	}{
+		{"positive", 2, 3, 6},
+		{"zero", 5, 0, 0},
+		{"negative", -2, 3, -6},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Mul(c.a, c.b); got != c.want {
+				t.Errorf("Mul(%d, %d) = %d, want %d", c.a, c.b, got, c.want)
+			}
+		})
+	}
+}
+
+func TestDiv(t *testing.T) {
+	result, err := Div(6, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != 2 {
+		t.Errorf("Expected 2, got %d", result)
+	}
+}
+
+func TestDivByZero(t *testing.T) {
+	_, err := Div(1, 0)
+	if !errors.Is(err, ErrDivideByZero) {
+		t.Errorf("Expected ErrDivideByZero, got %v", err)
+	}
+}
+
+func TestSum(t *testing.T) {
+	result := Sum(1, 2, 3, 4)
+	if result != 10 {
+		t.Errorf("Expected 10, got %d", result)
+	}
+}
+
+func TestAddG(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		cases := []struct {
+			name string
+			a, b, want int
+		}{
+			{"positive", 2, 3, 5},
+			{"negative", -2, -3, -5},
+		}
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				if got := AddG(c.a, c.b); got != c.want {
+					t.Errorf("AddG(%d, %d) = %d, want %d", c.a, c.b, got, c.want)
+				}
+			})
+		}
+	})
+
+	t.Run("int64", func(t *testing.T) {
+		cases := []struct {
+			name string
+			a, b, want int64
+		}{
+			{"positive", 2, 3, 5},
+			{"negative", -2, -3, -5},
+		}
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				if got := AddG(c.a, c.b); got != c.want {
+					t.Errorf("AddG(%d, %d) = %d, want %d", c.a, c.b, got, c.want)
+				}
+			})
+		}
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		cases := []struct {
+			name string
+			a, b, want float64
+		}{
+			{"positive", 2.5, 3.5, 6},
+			{"negative", -2.5, -3.5, -6},
+		}
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				if got := AddG(c.a, c.b); got != c.want {
+					t.Errorf("AddG(%v, %v) = %v, want %v", c.a, c.b, got, c.want)
+				}
+			})
+		}
+	})
+}
+
+func TestGreetIn(t *testing.T) {
+	cases := []struct {
+		lang     string
+		expected string
+	}{
+		{"en", "Hello from Hildie Go Library, World!"},
+		{"es", "¡Hola desde la Biblioteca Hildie Go, World!"},
+		{"fr", "Bonjour de la Bibliothèque Hildie Go, World!"},
+	}
+
+	for _, c := range cases {
+		result, err := GreetIn(c.lang, "World")
+		if err != nil {
+			t.Fatalf("GreetIn(%q) returned error: %v", c.lang, err)
+		}
+		if result != c.expected {
+			t.Errorf("GreetIn(%q) = %q, want %q", c.lang, result, c.expected)
+		}
+	}
+}
+
+func TestGreetInUnknownLang(t *testing.T) {
+	if _, err := GreetIn("de", "World"); err == nil {
+		t.Error("Expected error for unregistered lang, got nil")
+	}
+}
+
+func TestRegisterGreeterConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			RegisterGreeter("concurrent", func(name string) string {
+				return name
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	if _, err := GreetIn("concurrent", "World"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}